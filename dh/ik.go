@@ -0,0 +1,225 @@
+package dh
+
+import (
+	"errors"
+	"math"
+)
+
+// SolveSphericalWrist computes joint angles for a 6-row manipulator whose
+// last three joint axes intersect at a single point (a "spherical wrist"),
+// the standard design of industrial arms such as the PUMA, Kuka, or CRS F3.
+//
+// This lets the otherwise-coupled 6-DOF problem be decoupled: targetRot's
+// approach vector and wristOffset (the fixed distance from the wrist center
+// to the tool tip along that vector, i.e. DH parameter d6) locate the wrist
+// center, which joints 1-3 position; joints 4-6 then only have to rotate
+// the wrist to match targetRot, independent of position.
+func (m *Manipulator) SolveSphericalWrist(targetPos Vec3, targetRot Mat3, wristOffset float64) ([6]float64, error) {
+	if len(m.rows) != 6 {
+		return [6]float64{}, errors.New("dh: spherical-wrist IK requires a 6-row manipulator")
+	}
+
+	approach := Vec3{X: targetRot[0][2], Y: targetRot[1][2], Z: targetRot[2][2]}
+	wristCenter := Vec3{
+		X: targetPos.X - wristOffset*approach.X,
+		Y: targetPos.Y - wristOffset*approach.Y,
+		Z: targetPos.Z - wristOffset*approach.Z,
+	}
+
+	theta123, err := m.solveWristCenter(wristCenter)
+	if err != nil {
+		return [6]float64{}, err
+	}
+
+	frame3, err := m.FrameAt(2, []float64{theta123[0], theta123[1], theta123[2], 0, 0, 0})
+	if err != nil {
+		return [6]float64{}, err
+	}
+	r03 := frame3.Rotation()
+	r36 := r03.transpose().mul(targetRot)
+
+	theta4, theta5, theta6 := m.decoupleWristOrientation(r36)
+
+	return [6]float64{
+		theta123[0],
+		theta123[1],
+		theta123[2],
+		theta4 - m.rows[3].Theta,
+		theta5 - m.rows[4].Theta,
+		theta6 - m.rows[5].Theta,
+	}, nil
+}
+
+// decoupleWristOrientation recovers joints 4-6 from r36, the rotation a
+// spherical wrist must produce to go from frame 3 to frame 6.
+//
+// A spherical wrist's middle axis (joint 5) is perpendicular to the other
+// two, which is what alpha4 and alpha5 being +-90 degrees encodes. Which
+// joint's fixed alpha sits outside the three Rz rotations -- and so must be
+// undone before the remaining Rz*Rx*Rz*Rx collapses, by conjugation, into a
+// standard Z-Y-Z Euler form -- depends on whether a row's rotation is
+// Rz(theta)*Rx(alpha) (Standard) or Rx(alpha)*Rz(theta) (Modified): Standard
+// leaves joint 6's alpha trailing on the right, Modified leaves joint 4's
+// alpha leading on the left. Either way, eulerZYZ then solves for all three
+// angles at once, with the middle one's sign flipped back depending on which
+// way the conjugating alpha turned the axis.
+func (m *Manipulator) decoupleWristOrientation(r36 Mat3) (theta4, theta5, theta6 float64) {
+	if m.convention == Modified {
+		headRot := rotX(m.rows[3].Alpha).Rotation()
+		stripped := headRot.transpose().mul(r36)
+
+		a, b, c := eulerZYZ(stripped)
+
+		sign5 := 1.0
+		if m.rows[4].Alpha < 0 {
+			sign5 = -1.0
+		}
+		return a, -sign5 * b, c
+	}
+
+	tailRot := rotX(m.rows[5].Alpha).Rotation()
+	stripped := r36.mul(tailRot.transpose())
+
+	a, b, c := eulerZYZ(stripped)
+
+	sign4 := 1.0
+	if m.rows[3].Alpha < 0 {
+		sign4 = -1.0
+	}
+	return a, -sign4 * b, c
+}
+
+// positionThroughJoint3 returns the Cartesian position of the wrist center:
+// joint frame 3, after applying theta[0..2] and joint 4's fixed geometry.
+// Joint 4's own angle is held at zero because, for a true spherical wrist
+// (row 4's link length a4 is zero), rotating about joint 4's axis only
+// reorients the wrist -- it never moves the point where axes 4-6 intersect,
+// so frame 3's origin is that point regardless of theta4.
+func (m *Manipulator) positionThroughJoint3(theta [3]float64) (Vec3, error) {
+	frame, err := m.FrameAt(3, []float64{theta[0], theta[1], theta[2], 0, 0, 0})
+	if err != nil {
+		return Vec3{}, err
+	}
+	return frame.Translation(), nil
+}
+
+// solveWristCenter finds the first three joint angles that place joint
+// frame 3 (the wrist center, see positionThroughJoint3) at wristCenter, via
+// Newton's method with a numerically differentiated Jacobian, damped the
+// same way numik.dampedLeastSquares stabilizes the whole planar arm
+// (J^T(JJ^T+lambda^2 I)^-1 e). The first three DH rows of an industrial arm
+// rarely reduce to one universal closed form, so this solves them the same
+// way numik solves the whole planar arm: iteratively, from the error.
+//
+// The iteration starts from a bent, non-zero pose rather than all-zero
+// angles: an all-zero start sits exactly at a singularity (fully extended,
+// or axes aligned) for most industrial DH tables, including the standard
+// PUMA-560 one, which is precisely the configuration damping exists to
+// survive but is best avoided altogether.
+func (m *Manipulator) solveWristCenter(wristCenter Vec3) ([3]float64, error) {
+	const (
+		maxIterations = 200
+		epsilon       = 1e-6
+		h             = 1e-6  // finite-difference step
+		damping       = 0.001 // lambda, for the damped-least-squares update
+	)
+
+	theta := [3]float64{0.3, -0.6, 0.4}
+	for iter := 0; iter < maxIterations; iter++ {
+		pos, err := m.positionThroughJoint3(theta)
+		if err != nil {
+			return theta, err
+		}
+		e := [3]float64{wristCenter.X - pos.X, wristCenter.Y - pos.Y, wristCenter.Z - pos.Z}
+		if math.Sqrt(e[0]*e[0]+e[1]*e[1]+e[2]*e[2]) < epsilon {
+			return theta, nil
+		}
+
+		var J [3][3]float64
+		for j := 0; j < 3; j++ {
+			perturbed := theta
+			perturbed[j] += h
+			posPlus, err := m.positionThroughJoint3(perturbed)
+			if err != nil {
+				return theta, err
+			}
+			J[0][j] = (posPlus.X - pos.X) / h
+			J[1][j] = (posPlus.Y - pos.Y) / h
+			J[2][j] = (posPlus.Z - pos.Z) / h
+		}
+
+		delta, ok := dampedSolve3x3(J, e, damping)
+		if !ok {
+			return theta, errors.New("dh: wrist-center position solve hit a singular configuration")
+		}
+		for j := 0; j < 3; j++ {
+			theta[j] += delta[j]
+		}
+	}
+
+	return theta, errors.New("dh: wrist-center position solve did not converge within the iteration budget")
+}
+
+// dampedSolve3x3 solves the damped normal equations (J J^T + lambda^2 I) v = e
+// and returns x = J^T v, i.e. the Levenberg-Marquardt step for J*x = e. This
+// stays well-conditioned even when J itself is singular or near-singular, at
+// the cost of the exact solution when lambda is large.
+func dampedSolve3x3(J [3][3]float64, e [3]float64, lambda float64) (x [3]float64, ok bool) {
+	var jjt [3][3]float64
+	for r := 0; r < 3; r++ {
+		for c := 0; c < 3; c++ {
+			var sum float64
+			for k := 0; k < 3; k++ {
+				sum += J[r][k] * J[c][k]
+			}
+			jjt[r][c] = sum
+		}
+		jjt[r][r] += lambda * lambda
+	}
+
+	v, ok := solve3x3(jjt, e)
+	if !ok {
+		return x, false
+	}
+	for col := 0; col < 3; col++ {
+		for row := 0; row < 3; row++ {
+			x[col] += J[row][col] * v[row]
+		}
+	}
+	return x, true
+}
+
+// solve3x3 solves J*x = e for x using Cramer's rule, returning ok=false if
+// J is singular.
+func solve3x3(J [3][3]float64, e [3]float64) (x [3]float64, ok bool) {
+	det := det3(J)
+	if math.Abs(det) < 1e-12 {
+		return x, false
+	}
+
+	for col := 0; col < 3; col++ {
+		m := J
+		for row := 0; row < 3; row++ {
+			m[row][col] = e[row]
+		}
+		x[col] = det3(m) / det
+	}
+	return x, true
+}
+
+// det3 returns the determinant of a 3x3 matrix.
+func det3(m [3][3]float64) float64 {
+	return m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+}
+
+// eulerZYZ decomposes a rotation matrix into Z-Y-Z Euler angles (a, b, c)
+// such that R = RotZ(a) * RotY(b) * RotZ(c) -- the convention a spherical
+// wrist's three joint axes naturally follow.
+func eulerZYZ(r Mat3) (a, b, c float64) {
+	b = math.Atan2(math.Hypot(r[2][0], r[2][1]), r[2][2])
+	a = math.Atan2(r[1][2], r[0][2])
+	c = math.Atan2(r[2][1], -r[2][0])
+	return a, b, c
+}
@@ -0,0 +1,153 @@
+package dh
+
+import (
+	"math"
+	"testing"
+)
+
+// puma560 returns the standard DH table for a PUMA-560, a canonical
+// 6-DOF spherical-wrist industrial arm, with angles in radians and
+// lengths in meters.
+func puma560() *Manipulator {
+	deg := func(d float64) float64 { return d * math.Pi / 180 }
+	rows := []Row{
+		{A: 0, Alpha: deg(-90), D: 0, Theta: 0},
+		{A: 0.4318, Alpha: 0, D: 0, Theta: 0},
+		{A: 0.0203, Alpha: deg(-90), D: 0.15005, Theta: 0},
+		{A: 0, Alpha: deg(90), D: 0.4318, Theta: 0},
+		{A: 0, Alpha: deg(-90), D: 0, Theta: 0},
+		{A: 0, Alpha: 0, D: 0, Theta: 0},
+	}
+	return NewManipulator(Standard, rows)
+}
+
+// modifiedSphericalWrist returns a 6-row spherical-wrist manipulator
+// interpreted under the Modified (Craig) convention, to exercise
+// decoupleWristOrientation's other branch. Rows 4 and 5 satisfy the
+// spherical-wrist geometry (row 5's alpha is the negation of row 4's), the
+// condition the Modified decoupling relies on, the same way Standard's
+// puma560 table satisfies it for rows 3 and 4.
+func modifiedSphericalWrist() *Manipulator {
+	deg := func(d float64) float64 { return d * math.Pi / 180 }
+	rows := []Row{
+		{A: 0, Alpha: deg(-90), D: 0, Theta: 0},
+		{A: 0.4318, Alpha: 0, D: 0, Theta: 0},
+		{A: 0.0203, Alpha: deg(-90), D: 0.15005, Theta: 0},
+		{A: 0, Alpha: deg(90), D: 0.4318, Theta: 0},
+		{A: 0, Alpha: deg(90), D: 0, Theta: 0},
+		{A: 0, Alpha: deg(-90), D: 0, Theta: 0},
+	}
+	return NewManipulator(Modified, rows)
+}
+
+// TestSolveSphericalWrist_RoundTrip_Modified is TestSolveSphericalWrist_RoundTrip's
+// sibling for the Modified convention: jointTransform combines a row's theta
+// and alpha in the opposite order under Modified, so decoupleWristOrientation
+// needs its own, separately-verified code path rather than inheriting
+// Standard's by accident.
+func TestSolveSphericalWrist_RoundTrip_Modified(t *testing.T) {
+	m := modifiedSphericalWrist()
+	const wristOffset = 0.0
+
+	cases := [][6]float64{
+		{0.3, -0.5, 0.2, 0.1, 0.4, -0.2},
+		{-0.6, 0.8, -0.3, 0.5, -0.4, 0.6},
+		{0.0, 0.3, 0.1, 0.0, 0.2, 0.0},
+		{1.0, -1.0, 0.5, -0.3, 0.6, 0.2},
+	}
+
+	for _, want := range cases {
+		target, err := m.ForwardKinematics(want[:])
+		if err != nil {
+			t.Fatalf("ForwardKinematics(%v): %v", want, err)
+		}
+		targetPos := target.Translation()
+		targetRot := target.Rotation()
+
+		got, err := m.SolveSphericalWrist(targetPos, targetRot, wristOffset)
+		if err != nil {
+			t.Errorf("SolveSphericalWrist for pose from angles %v: %v", want, err)
+			continue
+		}
+
+		achieved, err := m.ForwardKinematics(got[:])
+		if err != nil {
+			t.Fatalf("ForwardKinematics(%v): %v", got, err)
+		}
+		achievedPos := achieved.Translation()
+
+		const tol = 1e-4
+		if math.Abs(achievedPos.X-targetPos.X) > tol ||
+			math.Abs(achievedPos.Y-targetPos.Y) > tol ||
+			math.Abs(achievedPos.Z-targetPos.Z) > tol {
+			t.Errorf("round-trip position mismatch for angles %v: want %+v, got %+v (solved angles %v)",
+				want, targetPos, achievedPos, got)
+		}
+
+		achievedRot := achieved.Rotation()
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				if math.Abs(achievedRot[i][j]-targetRot[i][j]) > 1e-3 {
+					t.Errorf("round-trip rotation mismatch for angles %v at [%d][%d]: want %v, got %v",
+						want, i, j, targetRot[i][j], achievedRot[i][j])
+				}
+			}
+		}
+	}
+}
+
+// TestSolveSphericalWrist_RoundTrip drives the manipulator to a known pose
+// via forward kinematics, then checks that SolveSphericalWrist recovers
+// joint angles whose forward kinematics lands back on that same pose --
+// the actual property the solver needs, since DH joint angles are not
+// unique.
+func TestSolveSphericalWrist_RoundTrip(t *testing.T) {
+	m := puma560()
+	const wristOffset = 0.0
+
+	cases := [][6]float64{
+		{0.3, -0.5, 0.2, 0.1, 0.4, -0.2},
+		{-0.6, 0.8, -0.3, 0.5, -0.4, 0.6},
+		{0.0, 0.3, 0.1, 0.0, 0.2, 0.0},
+		{1.0, -1.0, 0.5, -0.3, 0.6, 0.2},
+	}
+
+	for _, want := range cases {
+		target, err := m.ForwardKinematics(want[:])
+		if err != nil {
+			t.Fatalf("ForwardKinematics(%v): %v", want, err)
+		}
+		targetPos := target.Translation()
+		targetRot := target.Rotation()
+
+		got, err := m.SolveSphericalWrist(targetPos, targetRot, wristOffset)
+		if err != nil {
+			t.Errorf("SolveSphericalWrist for pose from angles %v: %v", want, err)
+			continue
+		}
+
+		achieved, err := m.ForwardKinematics(got[:])
+		if err != nil {
+			t.Fatalf("ForwardKinematics(%v): %v", got, err)
+		}
+		achievedPos := achieved.Translation()
+
+		const tol = 1e-4
+		if math.Abs(achievedPos.X-targetPos.X) > tol ||
+			math.Abs(achievedPos.Y-targetPos.Y) > tol ||
+			math.Abs(achievedPos.Z-targetPos.Z) > tol {
+			t.Errorf("round-trip position mismatch for angles %v: want %+v, got %+v (solved angles %v)",
+				want, targetPos, achievedPos, got)
+		}
+
+		achievedRot := achieved.Rotation()
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				if math.Abs(achievedRot[i][j]-targetRot[i][j]) > 1e-3 {
+					t.Errorf("round-trip rotation mismatch for angles %v at [%d][%d]: want %v, got %v",
+						want, i, j, targetRot[i][j], achievedRot[i][j])
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package dh
+
+import "errors"
+
+// Convention selects how a Row's parameters are combined into a transform.
+type Convention int
+
+const (
+	// Standard (Denavit's original) convention: a joint's transform
+	// carries the parameters describing the link that follows it.
+	Standard Convention = iota
+	// Modified (Craig's) convention: a joint's transform carries the
+	// parameters describing the link that precedes it.
+	Modified
+)
+
+// Row is one manipulator link's Denavit-Hartenberg parameters: link length
+// a, link twist alpha, link offset d, and joint angle theta. For a revolute
+// joint, Theta is the fixed offset added to the variable joint angle
+// supplied to ForwardKinematics; for a prismatic joint it would instead be
+// D that varies, but this package only models revolute joints.
+type Row struct {
+	A, Alpha, D, Theta float64
+}
+
+// Manipulator is an open kinematic chain described by DH parameters.
+type Manipulator struct {
+	rows       []Row
+	convention Convention
+}
+
+// NewManipulator returns a Manipulator for the given rows, interpreted
+// under the given convention.
+func NewManipulator(convention Convention, rows []Row) *Manipulator {
+	return &Manipulator{rows: rows, convention: convention}
+}
+
+// jointTransform returns the transform from joint i's frame to joint i+1's
+// frame, for the given variable joint angle.
+func (m *Manipulator) jointTransform(i int, theta float64) Mat4 {
+	row := m.rows[i]
+	angle := row.Theta + theta
+
+	if m.convention == Modified {
+		// Craig's convention: translate/rotate by the previous link's
+		// a and alpha first, then apply this joint's own rotation and
+		// offset.
+		return rotX(row.Alpha).mul(transX(row.A)).mul(rotZ(angle)).mul(transZ(row.D))
+	}
+	// Standard convention: this joint's own rotation and offset, then
+	// the link to the next joint.
+	return rotZ(angle).mul(transZ(row.D)).mul(transX(row.A)).mul(rotX(row.Alpha))
+}
+
+// ForwardKinematics returns the pose of the manipulator's end effector for
+// the given joint angles, one per row.
+func (m *Manipulator) ForwardKinematics(thetas []float64) (Mat4, error) {
+	if len(thetas) != len(m.rows) {
+		return Mat4{}, errors.New("dh: number of joint angles does not match the number of rows")
+	}
+
+	T := identity4()
+	for i := range m.rows {
+		T = T.mul(m.jointTransform(i, thetas[i]))
+	}
+	return T, nil
+}
+
+// FrameAt returns the pose of joint frame i (0-based, after applying
+// thetas[0..i]) rather than the full chain's end effector. SolveSphericalWrist uses
+// this to get the pose of the third joint when decoupling position from
+// orientation.
+func (m *Manipulator) FrameAt(i int, thetas []float64) (Mat4, error) {
+	if i < 0 || i >= len(m.rows) || len(thetas) < i+1 {
+		return Mat4{}, errors.New("dh: frame index out of range")
+	}
+
+	T := identity4()
+	for j := 0; j <= i; j++ {
+		T = T.mul(m.jointTransform(j, thetas[j]))
+	}
+	return T, nil
+}
@@ -0,0 +1,128 @@
+// Package dh implements forward and inverse kinematics for manipulators
+// described by Denavit-Hartenberg parameters, the standard way to model
+// arms with more degrees of freedom than the 2-segment SCARA case this
+// module started from -- 3-DOF and 6-DOF arms like the PUMA or Kuka series.
+package dh
+
+import "math"
+
+// Vec3 is a point or vector in the manipulator's 3D workspace.
+type Vec3 struct {
+	X, Y, Z float64
+}
+
+// Mat4 is a 4x4 homogeneous transform: the top-left 3x3 block is a rotation,
+// the top-right column a translation.
+type Mat4 [4][4]float64
+
+// Mat3 is a 3x3 rotation matrix.
+type Mat3 [3][3]float64
+
+// Translation returns the transform's translation component.
+func (m Mat4) Translation() Vec3 {
+	return m.translation()
+}
+
+// Rotation returns the transform's rotation component.
+func (m Mat4) Rotation() Mat3 {
+	return m.rotation()
+}
+
+// identity4 returns the identity transform.
+func identity4() Mat4 {
+	var m Mat4
+	for i := 0; i < 4; i++ {
+		m[i][i] = 1
+	}
+	return m
+}
+
+// mul returns m * other.
+func (m Mat4) mul(other Mat4) Mat4 {
+	var r Mat4
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			var sum float64
+			for k := 0; k < 4; k++ {
+				sum += m[i][k] * other[k][j]
+			}
+			r[i][j] = sum
+		}
+	}
+	return r
+}
+
+// translation returns the transform's translation component.
+func (m Mat4) translation() Vec3 {
+	return Vec3{X: m[0][3], Y: m[1][3], Z: m[2][3]}
+}
+
+// rotation returns the transform's 3x3 rotation block.
+func (m Mat4) rotation() Mat3 {
+	var r Mat3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r[i][j] = m[i][j]
+		}
+	}
+	return r
+}
+
+// mul returns a * b.
+func (a Mat3) mul(b Mat3) Mat3 {
+	var r Mat3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			var sum float64
+			for k := 0; k < 3; k++ {
+				sum += a[i][k] * b[k][j]
+			}
+			r[i][j] = sum
+		}
+	}
+	return r
+}
+
+// transpose returns the transpose of a rotation matrix, which for a valid
+// rotation is also its inverse.
+func (a Mat3) transpose() Mat3 {
+	var t Mat3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			t[i][j] = a[j][i]
+		}
+	}
+	return t
+}
+
+// rotZ returns the homogeneous transform that rotates by theta around Z.
+func rotZ(theta float64) Mat4 {
+	m := identity4()
+	c, s := math.Cos(theta), math.Sin(theta)
+	m[0][0], m[0][1] = c, -s
+	m[1][0], m[1][1] = s, c
+	return m
+}
+
+// rotX returns the homogeneous transform that rotates by alpha around X.
+func rotX(alpha float64) Mat4 {
+	m := identity4()
+	c, s := math.Cos(alpha), math.Sin(alpha)
+	m[1][1], m[1][2] = c, -s
+	m[2][1], m[2][2] = s, c
+	return m
+}
+
+// transZ returns the homogeneous transform that translates by d along Z.
+func transZ(d float64) Mat4 {
+	m := identity4()
+	m[2][3] = d
+	return m
+}
+
+// transX returns the homogeneous transform that translates by a along X.
+func transX(a float64) Mat4 {
+	m := identity4()
+	m[0][3] = a
+	return m
+}
@@ -0,0 +1,100 @@
+package driver
+
+import "io"
+
+// Dynamixel protocol 1.0 constants (as used by AX-12 and similar servos).
+// Only what this driver needs is defined here; see the Dynamixel protocol
+// documentation for the rest of the instruction set.
+const (
+	dynamixelHeader               = 0xFF
+	dynamixelBroadcastID          = 0xFE
+	dynamixelInstructionWrite     = 0x03
+	dynamixelInstructionSyncWrite = 0x83
+	dynamixelGoalPositionAddr     = 0x1E // low byte; high byte follows at +1
+)
+
+// DynamixelJoint configures one joint wired to a Dynamixel servo.
+type DynamixelJoint struct {
+	ID          byte // the servo's Dynamixel ID on the bus
+	Calibration Calibration
+}
+
+// Dynamixel drives servos over a Dynamixel protocol 1.0 serial bus, as used
+// by Robotis AX-12 and similar servos daisy-chained on a single half-duplex
+// serial line.
+type Dynamixel struct {
+	port   io.ReadWriter
+	joints map[int]DynamixelJoint
+}
+
+// NewDynamixel returns a driver that talks to the given joints over port,
+// an already-opened serial connection at the bus's configured baud rate.
+func NewDynamixel(port io.ReadWriter, joints map[int]DynamixelJoint) *Dynamixel {
+	return &Dynamixel{port: port, joints: joints}
+}
+
+// SetAngle moves a single servo to the given angle.
+func (d *Dynamixel) SetAngle(jointID int, radians float64) error {
+	j, ok := d.joints[jointID]
+	if !ok {
+		return errUnconfiguredJoint(jointID)
+	}
+	pos := uint16(j.Calibration.ToRaw(radians))
+	_, err := d.port.Write(writePacket(j.ID, dynamixelGoalPositionAddr, []byte{byte(pos), byte(pos >> 8)}))
+	return err
+}
+
+// SetAngles moves every given joint using a single SYNC WRITE instruction,
+// so all servos start moving at the same moment instead of one after
+// another.
+func (d *Dynamixel) SetAngles(angles map[int]float64) error {
+	params := []byte{dynamixelGoalPositionAddr, 2} // start address, bytes per servo
+	for jointID, radians := range angles {
+		j, ok := d.joints[jointID]
+		if !ok {
+			return errUnconfiguredJoint(jointID)
+		}
+		pos := uint16(j.Calibration.ToRaw(radians))
+		params = append(params, j.ID, byte(pos), byte(pos>>8))
+	}
+	_, err := d.port.Write(syncWritePacket(params))
+	return err
+}
+
+// Close releases the serial port, if it supports being closed.
+func (d *Dynamixel) Close() error {
+	if c, ok := d.port.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// writePacket builds a Dynamixel protocol 1.0 WRITE DATA instruction packet
+// addressed to a single servo.
+func writePacket(id, addr byte, data []byte) []byte {
+	params := append([]byte{addr}, data...)
+	return packet(id, dynamixelInstructionWrite, params)
+}
+
+// syncWritePacket builds a Dynamixel protocol 1.0 SYNC WRITE instruction
+// packet, broadcast to every servo listed in params.
+func syncWritePacket(params []byte) []byte {
+	return packet(dynamixelBroadcastID, dynamixelInstructionSyncWrite, params)
+}
+
+// packet assembles a full Dynamixel protocol 1.0 packet and appends its
+// checksum.
+func packet(id, instruction byte, params []byte) []byte {
+	length := byte(len(params) + 2)
+
+	pkt := []byte{dynamixelHeader, dynamixelHeader, id, length, instruction}
+	pkt = append(pkt, params...)
+
+	sum := int(id) + int(length) + int(instruction)
+	for _, b := range params {
+		sum += int(b)
+	}
+	checksum := ^byte(sum)
+
+	return append(pkt, checksum)
+}
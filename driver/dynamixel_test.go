@@ -0,0 +1,59 @@
+package driver
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWritePacketFraming(t *testing.T) {
+	got := writePacket(1, dynamixelGoalPositionAddr, []byte{0x10, 0x00})
+	want := []byte{0xFF, 0xFF, 0x01, 0x05, 0x03, 0x1E, 0x10, 0x00, 0xC8}
+	if !bytes.Equal(got, want) {
+		t.Errorf("writePacket = % X, want % X", got, want)
+	}
+}
+
+func TestSyncWritePacketFraming(t *testing.T) {
+	params := []byte{dynamixelGoalPositionAddr, 2, 1, 0x10, 0x00, 2, 0x20, 0x00}
+	got := syncWritePacket(params)
+	want := []byte{0xFF, 0xFF, 0xFE, 0x0A, 0x83, 0x1E, 0x02, 0x01, 0x10, 0x00, 0x02, 0x20, 0x00, 0x21}
+	if !bytes.Equal(got, want) {
+		t.Errorf("syncWritePacket = % X, want % X", got, want)
+	}
+}
+
+type fakeSerialPort struct {
+	written []byte
+}
+
+func (p *fakeSerialPort) Write(b []byte) (int, error) {
+	p.written = append(p.written, b...)
+	return len(b), nil
+}
+
+func (p *fakeSerialPort) Read(b []byte) (int, error) {
+	return 0, nil
+}
+
+func TestDynamixelSetAngleWritesCalibratedPosition(t *testing.T) {
+	port := &fakeSerialPort{}
+	d := NewDynamixel(port, map[int]DynamixelJoint{
+		0: {ID: 5, Calibration: Calibration{MinAngle: 0, MaxAngle: 1, MinRaw: 0, MaxRaw: 1023}},
+	})
+
+	if err := d.SetAngle(0, 0.5); err != nil {
+		t.Fatalf("SetAngle: %v", err)
+	}
+
+	want := writePacket(5, dynamixelGoalPositionAddr, []byte{0xFF, 0x01})
+	if !bytes.Equal(port.written, want) {
+		t.Errorf("port received % X, want % X", port.written, want)
+	}
+}
+
+func TestDynamixelSetAngleRejectsUnconfiguredJoint(t *testing.T) {
+	d := NewDynamixel(&fakeSerialPort{}, map[int]DynamixelJoint{})
+	if err := d.SetAngle(0, 0); err == nil {
+		t.Error("SetAngle(unconfigured joint) = nil error, want error")
+	}
+}
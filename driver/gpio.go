@@ -0,0 +1,105 @@
+package driver
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// SysfsPWMJoint configures one joint wired to a GPIO pin driven through the
+// Linux sysfs PWM interface.
+type SysfsPWMJoint struct {
+	Chip        int // the pwmchipN under /sys/class/pwm
+	Channel     int // the pwmM channel within that chip
+	Calibration Calibration
+}
+
+// SysfsPWM drives servos through the Linux kernel's sysfs PWM interface
+// (/sys/class/pwm/pwmchipN/pwmM/...), the simplest way to get PWM out of a
+// GPIO pin without a dedicated PWM controller chip -- the approach used by
+// pigpio and similar Raspberry Pi GPIO libraries.
+type SysfsPWM struct {
+	periodNs float64
+	joints   map[int]SysfsPWMJoint
+}
+
+// NewSysfsPWM exports and enables the PWM channel for each given joint, at
+// the given PWM period (20ms / 50Hz is standard for analog hobby servos).
+func NewSysfsPWM(periodNs float64, joints map[int]SysfsPWMJoint) (*SysfsPWM, error) {
+	s := &SysfsPWM{periodNs: periodNs, joints: joints}
+	for jointID, j := range joints {
+		if err := s.export(j); err != nil {
+			return nil, fmt.Errorf("driver: exporting PWM for joint %d: %w", jointID, err)
+		}
+		if err := writeSysfsFile(s.pwmPath(j, "period"), int(periodNs)); err != nil {
+			return nil, err
+		}
+		if err := writeSysfsFile(s.pwmPath(j, "enable"), 1); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// SetAngle moves the servo on the configured GPIO channel for jointID to
+// the given angle.
+func (s *SysfsPWM) SetAngle(jointID int, radians float64) error {
+	j, ok := s.joints[jointID]
+	if !ok {
+		return errUnconfiguredJoint(jointID)
+	}
+	pulseNs := j.Calibration.ToRaw(radians)
+	return writeSysfsFile(s.pwmPath(j, "duty_cycle"), int(pulseNs))
+}
+
+// SetAngles moves every given joint in turn. Plain sysfs PWM channels have
+// no hardware synchronization, so there is no way to start them all in the
+// same instant; each write takes effect as soon as the kernel processes it.
+func (s *SysfsPWM) SetAngles(angles map[int]float64) error {
+	for jointID, radians := range angles {
+		if err := s.SetAngle(jointID, radians); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close disables every configured PWM channel.
+func (s *SysfsPWM) Close() error {
+	for _, j := range s.joints {
+		if err := writeSysfsFile(s.pwmPath(j, "enable"), 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// export requests the kernel create the sysfs files for j's PWM channel.
+// It is not an error if they already exist from a previous run: re-exporting
+// an already-exported channel fails the write, but with EBUSY rather than
+// EEXIST, so the write error alone can't tell "already exported" apart from
+// a real failure. Stat the directory the export would have created instead
+// -- its presence is what actually matters, regardless of why the write
+// itself failed.
+func (s *SysfsPWM) export(j SysfsPWMJoint) error {
+	exportPath := filepath.Join("/sys/class/pwm", fmt.Sprintf("pwmchip%d", j.Chip), "export")
+	err := writeSysfsFile(exportPath, j.Channel)
+	if err == nil {
+		return nil
+	}
+	if _, statErr := os.Stat(filepath.Join("/sys/class/pwm", fmt.Sprintf("pwmchip%d", j.Chip), fmt.Sprintf("pwm%d", j.Channel))); statErr == nil {
+		return nil
+	}
+	return err
+}
+
+// pwmPath returns the path to one attribute file of j's PWM channel.
+func (s *SysfsPWM) pwmPath(j SysfsPWMJoint, attr string) string {
+	return filepath.Join("/sys/class/pwm", fmt.Sprintf("pwmchip%d", j.Chip), fmt.Sprintf("pwm%d", j.Channel), attr)
+}
+
+// writeSysfsFile writes an integer value to a sysfs attribute file, the way
+// the kernel's sysfs PWM interface expects.
+func writeSysfsFile(path string, value int) error {
+	return os.WriteFile(path, []byte(fmt.Sprintf("%d", value)), 0644)
+}
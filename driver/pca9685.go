@@ -0,0 +1,100 @@
+package driver
+
+import "math"
+
+// PCA9685 registers, per the NXP PCA9685 datasheet. Only the ones this
+// driver needs are listed.
+const (
+	pca9685Mode1     = 0x00
+	pca9685Prescale  = 0xFE
+	pca9685Led0OnL   = 0x06
+	pca9685RegsPerCh = 4
+
+	pca9685OscClockHz = 25000000.0
+	pca9685Resolution = 4096 // 12-bit PWM counter
+)
+
+// I2CBus is the minimal I2C access the PCA9685 driver needs. It is small on
+// purpose so any I2C library's device handle can satisfy it.
+type I2CBus interface {
+	WriteReg(reg byte, data []byte) error
+}
+
+// PCA9685Joint configures one joint wired to a PCA9685 channel.
+type PCA9685Joint struct {
+	Channel     int // 0-15
+	Calibration Calibration
+}
+
+// PCA9685 drives servos through a PCA9685 16-channel I2C PWM controller, the
+// chip commonly paired with SG90 or MG996 hobby servos.
+type PCA9685 struct {
+	bus    I2CBus
+	freqHz float64
+	joints map[int]PCA9685Joint
+}
+
+// NewPCA9685 initializes the PCA9685 for the given PWM frequency (50Hz is
+// the standard rate for analog hobby servos) and returns a driver for the
+// given joints.
+func NewPCA9685(bus I2CBus, freqHz float64, joints map[int]PCA9685Joint) (*PCA9685, error) {
+	prescale := byte(math.Round(pca9685OscClockHz/(pca9685Resolution*freqHz)) - 1)
+
+	// The prescale register can only be written while the oscillator is
+	// off (sleep bit set in MODE1), so this follows the datasheet's
+	// documented restart sequence.
+	if err := bus.WriteReg(pca9685Mode1, []byte{0x10}); err != nil { // sleep
+		return nil, err
+	}
+	if err := bus.WriteReg(pca9685Prescale, []byte{prescale}); err != nil {
+		return nil, err
+	}
+	if err := bus.WriteReg(pca9685Mode1, []byte{0xA0}); err != nil { // restart, auto-increment
+		return nil, err
+	}
+
+	return &PCA9685{bus: bus, freqHz: freqHz, joints: joints}, nil
+}
+
+// SetAngle moves the servo on the configured channel for jointID to the
+// given angle.
+func (p *PCA9685) SetAngle(jointID int, radians float64) error {
+	j, ok := p.joints[jointID]
+	if !ok {
+		return errUnconfiguredJoint(jointID)
+	}
+	return p.setChannelPulse(j.Channel, j.Calibration.ToRaw(radians))
+}
+
+// SetAngles moves every given joint in turn. The PCA9685's channels run
+// independently once programmed, so the servos reach their targets together
+// even though the register writes themselves are sequential.
+func (p *PCA9685) SetAngles(angles map[int]float64) error {
+	for jointID, radians := range angles {
+		if err := p.SetAngle(jointID, radians); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close is a no-op: the PCA9685 has no persistent connection to release
+// beyond the I2C bus, which the caller owns.
+func (p *PCA9685) Close() error {
+	return nil
+}
+
+// setChannelPulse programs channel to start its pulse at tick 0 and end it
+// after pulseUs microseconds, expressed as one of the PCA9685's 4096 ticks
+// per PWM period.
+func (p *PCA9685) setChannelPulse(channel int, pulseUs float64) error {
+	periodUs := 1e6 / p.freqHz
+	offTicks := uint16(pulseUs / periodUs * pca9685Resolution)
+
+	reg := byte(pca9685Led0OnL + pca9685RegsPerCh*channel)
+	data := []byte{
+		0x00, 0x00, // ON_L, ON_H: start of pulse, always tick 0
+		byte(offTicks), byte(offTicks >> 8), // OFF_L, OFF_H: end of pulse
+	}
+	return p.bus.WriteReg(reg, data)
+}
@@ -0,0 +1,42 @@
+package driver
+
+import (
+	"bytes"
+	"testing"
+)
+
+type fakeI2CBus struct {
+	writes map[byte][]byte
+}
+
+func (b *fakeI2CBus) WriteReg(reg byte, data []byte) error {
+	if b.writes == nil {
+		b.writes = make(map[byte][]byte)
+	}
+	b.writes[reg] = append([]byte(nil), data...)
+	return nil
+}
+
+func TestSetChannelPulseTicks(t *testing.T) {
+	bus := &fakeI2CBus{}
+	p := &PCA9685{bus: bus, freqHz: 50}
+
+	// At 50Hz the period is 20000us; a 1500us pulse is 1500/20000 = 7.5%
+	// of the way through the 4096-tick period, i.e. tick 307.
+	if err := p.setChannelPulse(3, 1500); err != nil {
+		t.Fatalf("setChannelPulse: %v", err)
+	}
+
+	reg := byte(pca9685Led0OnL + pca9685RegsPerCh*3)
+	want := []byte{0x00, 0x00, 0x33, 0x01} // offTicks 307 = 0x0133, little-endian
+	if got := bus.writes[reg]; !bytes.Equal(got, want) {
+		t.Errorf("WriteReg(%#x) = % X, want % X", reg, got, want)
+	}
+}
+
+func TestPCA9685SetAngleRejectsUnconfiguredJoint(t *testing.T) {
+	p := &PCA9685{bus: &fakeI2CBus{}, freqHz: 50, joints: map[int]PCA9685Joint{}}
+	if err := p.SetAngle(0, 0); err == nil {
+		t.Error("SetAngle(unconfigured joint) = nil error, want error")
+	}
+}
@@ -0,0 +1,53 @@
+// Package driver connects the angles the solvers in this module compute to
+// physical servos, via a small ServoBus interface and a few concrete
+// implementations for common hobby and industrial hardware.
+package driver
+
+import "fmt"
+
+// ServoBus drives a set of servos, addressed by an arbitrary joint ID chosen
+// by the caller (typically the joint's index in the kinematic chain).
+type ServoBus interface {
+	// SetAngle moves a single joint to the given angle, in radians,
+	// following the same convention as the rest of this module.
+	SetAngle(jointID int, radians float64) error
+	// SetAngles moves several joints at once. Implementations should
+	// send all updates together where the underlying protocol allows
+	// it, so the servos move in sync rather than one after another.
+	SetAngles(angles map[int]float64) error
+	// Close releases the underlying bus or port.
+	Close() error
+}
+
+// Calibration maps a joint's kinematic angle (in radians, following the
+// solvers' convention) to the raw value the hardware expects -- a PWM pulse
+// width in microseconds, a Dynamixel position tick, or whatever unit a
+// driver's protocol uses.
+type Calibration struct {
+	MinAngle, MaxAngle float64 // radians; the joint's physical range of motion
+	MinRaw, MaxRaw     float64 // the driver-specific raw value at MinAngle and MaxAngle
+	OffsetRad          float64 // mechanical zero offset, added before mapping
+	Reverse            bool    // true if the servo is mounted rotated 180°
+}
+
+// ToRaw converts a kinematic angle into the driver-specific raw value,
+// clamping it to the calibration's configured range.
+func (c Calibration) ToRaw(angleRad float64) float64 {
+	angle := angleRad + c.OffsetRad
+	if c.Reverse {
+		angle = c.MinAngle + c.MaxAngle - angle
+	}
+	if angle < c.MinAngle {
+		angle = c.MinAngle
+	} else if angle > c.MaxAngle {
+		angle = c.MaxAngle
+	}
+
+	t := (angle - c.MinAngle) / (c.MaxAngle - c.MinAngle)
+	return c.MinRaw + t*(c.MaxRaw-c.MinRaw)
+}
+
+// errUnconfiguredJoint reports that a joint ID has no calibration entry.
+func errUnconfiguredJoint(jointID int) error {
+	return fmt.Errorf("driver: joint %d has no calibration configured", jointID)
+}
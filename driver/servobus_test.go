@@ -0,0 +1,38 @@
+package driver
+
+import "testing"
+
+func TestCalibrationToRaw(t *testing.T) {
+	c := Calibration{MinAngle: 0, MaxAngle: 1, MinRaw: 1000, MaxRaw: 2000}
+
+	if got := c.ToRaw(0.5); got != 1500 {
+		t.Errorf("ToRaw(0.5) = %v, want 1500", got)
+	}
+}
+
+func TestCalibrationToRawClamps(t *testing.T) {
+	c := Calibration{MinAngle: 0, MaxAngle: 1, MinRaw: 1000, MaxRaw: 2000}
+
+	if got := c.ToRaw(-1); got != 1000 {
+		t.Errorf("ToRaw(-1) = %v, want 1000 (clamped to MinRaw)", got)
+	}
+	if got := c.ToRaw(2); got != 2000 {
+		t.Errorf("ToRaw(2) = %v, want 2000 (clamped to MaxRaw)", got)
+	}
+}
+
+func TestCalibrationToRawReverse(t *testing.T) {
+	c := Calibration{MinAngle: 0, MaxAngle: 1, MinRaw: 1000, MaxRaw: 2000, Reverse: true}
+
+	if got := c.ToRaw(0.25); got != 1750 {
+		t.Errorf("ToRaw(0.25) with Reverse = %v, want 1750", got)
+	}
+}
+
+func TestCalibrationToRawOffset(t *testing.T) {
+	c := Calibration{MinAngle: 0, MaxAngle: 1, MinRaw: 1000, MaxRaw: 2000, OffsetRad: 0.5}
+
+	if got := c.ToRaw(0); got != 1500 {
+		t.Errorf("ToRaw(0) with OffsetRad 0.5 = %v, want 1500", got)
+	}
+}
@@ -0,0 +1,129 @@
+package numik
+
+import (
+	"errors"
+	"math"
+)
+
+// fabrikMaxIterations and fabrikEpsilon bound the FABRIK solver below, mirroring
+// the constants used for the Jacobian-based Solve.
+const (
+	fabrikMaxIterations = 1000
+	fabrikEpsilon       = 1e-4
+)
+
+// jointPositions returns the origin followed by the position of every
+// joint of the chain for the given joint angles.
+func (c *Chain) jointPositions(theta []float64) []Vec2 {
+	positions := make([]Vec2, len(c.segments)+1)
+	var sum float64
+	for i, s := range c.segments {
+		sum += theta[i]
+		positions[i+1] = Vec2{
+			X: positions[i].X + s.length*math.Cos(sum),
+			Y: positions[i].Y + s.length*math.Sin(sum),
+		}
+	}
+	return positions
+}
+
+// SolveFABRIK finds joint angles that bring chain's end effector to target
+// using Forward And Backward Reaching Inverse Kinematics. Unlike the
+// Jacobian-based Solve, FABRIK reasons directly about joint positions, which
+// makes it converge in far fewer iterations for chains with many segments.
+//
+// Joint limits are enforced by clamping the angle of each bone relative to
+// its parent (the previous bone, or the x axis for the first one) before the
+// bone is renormalized to its fixed length.
+func SolveFABRIK(c *Chain, target Vec2) ([]float64, error) {
+	n := len(c.segments)
+	if n == 0 {
+		return nil, errors.New("numik: chain has no segments")
+	}
+
+	min, max := c.reach()
+	dist := math.Hypot(target.X, target.Y)
+	if dist > max || dist < min {
+		return nil, errors.New("numik: target is outside the reachable annulus")
+	}
+
+	base := Vec2{}
+	positions := c.jointPositions(make([]float64, n))
+
+	for iter := 0; iter < fabrikMaxIterations; iter++ {
+		if math.Hypot(target.X-positions[n].X, target.Y-positions[n].Y) < fabrikEpsilon {
+			return c.anglesFromPositions(positions), nil
+		}
+
+		// Backward pass: pull the end effector onto the target, then
+		// drag each preceding joint towards its successor.
+		positions[n] = target
+		for i := n - 1; i >= 0; i-- {
+			positions[i] = moveToward(positions[i+1], positions[i], c.segments[i].length)
+		}
+
+		// Forward pass: re-anchor the base, then push each joint back
+		// out towards its predecessor, respecting the joint's angle
+		// limits relative to the previous bone.
+		positions[0] = base
+		prevAngle := 0.0
+		for i := 1; i <= n; i++ {
+			dir := moveToward(positions[i-1], positions[i], c.segments[i-1].length)
+			angle := clampRelativeAngle(math.Atan2(dir.Y-positions[i-1].Y, dir.X-positions[i-1].X), prevAngle, c.segments[i-1].minAngle, c.segments[i-1].maxAngle)
+			positions[i] = Vec2{
+				X: positions[i-1].X + c.segments[i-1].length*math.Cos(angle),
+				Y: positions[i-1].Y + c.segments[i-1].length*math.Sin(angle),
+			}
+			prevAngle = angle
+		}
+	}
+
+	return nil, errors.New("numik: FABRIK solver did not converge within the iteration budget")
+}
+
+// moveToward returns the point that is distance d away from from, along the
+// line from "from" towards "to".
+func moveToward(from, to Vec2, d float64) Vec2 {
+	dx, dy := to.X-from.X, to.Y-from.Y
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return Vec2{X: from.X + d, Y: from.Y}
+	}
+	return Vec2{X: from.X + d*dx/length, Y: from.Y + d*dy/length}
+}
+
+// clampRelativeAngle clamps absoluteAngle so that, relative to prevAngle, it
+// stays within [minAngle, maxAngle].
+func clampRelativeAngle(absoluteAngle, prevAngle, minAngle, maxAngle float64) float64 {
+	relative := normalizeAngle(absoluteAngle - prevAngle)
+	if relative < minAngle {
+		relative = minAngle
+	} else if relative > maxAngle {
+		relative = maxAngle
+	}
+	return prevAngle + relative
+}
+
+// normalizeAngle wraps an angle into (-Pi, Pi].
+func normalizeAngle(a float64) float64 {
+	for a > math.Pi {
+		a -= 2 * math.Pi
+	}
+	for a <= -math.Pi {
+		a += 2 * math.Pi
+	}
+	return a
+}
+
+// anglesFromPositions derives each joint's angle, relative to the previous
+// bone, from a sequence of joint positions.
+func (c *Chain) anglesFromPositions(positions []Vec2) []float64 {
+	theta := make([]float64, len(c.segments))
+	prevAngle := 0.0
+	for i := range c.segments {
+		absoluteAngle := math.Atan2(positions[i+1].Y-positions[i].Y, positions[i+1].X-positions[i].X)
+		theta[i] = normalizeAngle(absoluteAngle - prevAngle)
+		prevAngle = absoluteAngle
+	}
+	return theta
+}
@@ -0,0 +1,184 @@
+// Package numik implements numeric inverse kinematics for planar arms with
+// an arbitrary number of revolute joints, as promised (but not delivered) by
+// the original article. Where the geometric approach in the top-level
+// package only works for two segments, the Jacobian-based solver here
+// iteratively converges on a solution for chains of any length.
+package numik
+
+import (
+	"errors"
+	"math"
+)
+
+// Vec2 is a point or vector in the arm's 2D workspace.
+type Vec2 struct {
+	X, Y float64
+}
+
+// segment describes one link of the chain: its length and the angular
+// limits of the joint at its base.
+type segment struct {
+	length             float64
+	minAngle, maxAngle float64
+}
+
+// Chain is an open kinematic chain of revolute joints, each followed by a
+// rigid segment, anchored at the origin.
+type Chain struct {
+	segments []segment
+}
+
+// Solver tuning constants. They are generous enough for the small chains
+// this package targets. Solve always starts from the fully-extended pose;
+// there is no way to warm-start it from a previous solution.
+const (
+	maxIterations = 1000
+	epsilon       = 1e-4
+	stepSize      = 0.5 // alpha, for the Jacobian-transpose update
+	damping       = 0.5 // lambda, for the damped-least-squares update
+)
+
+// AddSegment appends a new segment of the given length to the end of the
+// chain, with its joint constrained to [minAngle, maxAngle] radians.
+func (c *Chain) AddSegment(length, minAngle, maxAngle float64) {
+	c.segments = append(c.segments, segment{length: length, minAngle: minAngle, maxAngle: maxAngle})
+}
+
+// reach returns the minimum and maximum distance from the origin that the
+// chain's end effector can reach.
+func (c *Chain) reach() (min, max float64) {
+	for _, s := range c.segments {
+		max += s.length
+		if s.length > min {
+			min = s.length
+		}
+	}
+	min = 2*min - max
+	if min < 0 {
+		min = 0
+	}
+	return min, max
+}
+
+// endEffector returns the position of the tip of the chain for the given
+// joint angles, each measured relative to the previous segment.
+func (c *Chain) endEffector(theta []float64) Vec2 {
+	var p Vec2
+	var sum float64
+	for i, s := range c.segments {
+		sum += theta[i]
+		p.X += s.length * math.Cos(sum)
+		p.Y += s.length * math.Sin(sum)
+	}
+	return p
+}
+
+// jacobian builds the 2xN Jacobian of the end-effector position with
+// respect to the joint angles theta, evaluated at the given angles.
+func (c *Chain) jacobian(theta []float64) (jx, jy []float64) {
+	n := len(c.segments)
+	jx, jy = make([]float64, n), make([]float64, n)
+
+	// cumAngle[j] is the sum theta[0]+...+theta[j].
+	cumAngle := make([]float64, n)
+	var sum float64
+	for j, t := range theta {
+		sum += t
+		cumAngle[j] = sum
+	}
+
+	for i := 0; i < n; i++ {
+		var dx, dy float64
+		for j := i; j < n; j++ {
+			dx -= c.segments[j].length * math.Sin(cumAngle[j])
+			dy += c.segments[j].length * math.Cos(cumAngle[j])
+		}
+		jx[i], jy[i] = dx, dy
+	}
+	return jx, jy
+}
+
+// clampAngles clamps each joint angle to its configured limits, in place.
+func (c *Chain) clampAngles(theta []float64) {
+	for i, s := range c.segments {
+		if theta[i] < s.minAngle {
+			theta[i] = s.minAngle
+		} else if theta[i] > s.maxAngle {
+			theta[i] = s.maxAngle
+		}
+	}
+}
+
+// Solve finds joint angles that bring the end effector to target, starting
+// from a straight, fully-extended pose. Each iteration takes a damped
+// least-squares step (robust near singularities); a plain Jacobian-transpose
+// step is taken instead only when the damped 2x2 system is itself singular.
+//
+// Solve returns an error if target lies outside the chain's reachable
+// annulus, or if no solution converges within the iteration budget.
+func (c *Chain) Solve(target Vec2) ([]float64, error) {
+	if len(c.segments) == 0 {
+		return nil, errors.New("numik: chain has no segments")
+	}
+
+	min, max := c.reach()
+	dist := math.Hypot(target.X, target.Y)
+	if dist > max || dist < min {
+		return nil, errors.New("numik: target is outside the reachable annulus")
+	}
+
+	theta := make([]float64, len(c.segments))
+
+	for iter := 0; iter < maxIterations; iter++ {
+		pos := c.endEffector(theta)
+		ex, ey := target.X-pos.X, target.Y-pos.Y
+		if math.Hypot(ex, ey) < epsilon {
+			return theta, nil
+		}
+
+		jx, jy := c.jacobian(theta)
+		dTheta := dampedLeastSquares(jx, jy, ex, ey)
+		for i := range theta {
+			theta[i] += dTheta[i]
+		}
+		c.clampAngles(theta)
+	}
+
+	return nil, errors.New("numik: solver did not converge within the iteration budget")
+}
+
+// dampedLeastSquares computes dTheta = J^T (J J^T + lambda^2 I)^-1 e for the
+// 2xN Jacobian given by (jx, jy) and error vector e = (ex, ey). Since J J^T
+// is only 2x2 here, the inverse is computed directly rather than with a
+// general linear-algebra routine.
+func dampedLeastSquares(jx, jy []float64, ex, ey float64) []float64 {
+	var a, b, d float64 // J J^T = [[a, b], [b, d]]
+	for i := range jx {
+		a += jx[i] * jx[i]
+		b += jx[i] * jy[i]
+		d += jy[i] * jy[i]
+	}
+	a += damping * damping
+	d += damping * damping
+
+	det := a*d - b*b
+	if math.Abs(det) < 1e-12 {
+		// J J^T is singular even after damping: fall back to a plain
+		// Jacobian-transpose step instead of dividing by ~zero.
+		dTheta := make([]float64, len(jx))
+		for i := range jx {
+			dTheta[i] = stepSize * (jx[i]*ex + jy[i]*ey)
+		}
+		return dTheta
+	}
+
+	// Solve [[a, b], [b, d]] * [vx, vy]^T = [ex, ey]^T.
+	vx := (d*ex - b*ey) / det
+	vy := (a*ey - b*ex) / det
+
+	dTheta := make([]float64, len(jx))
+	for i := range jx {
+		dTheta[i] = jx[i]*vx + jy[i]*vy
+	}
+	return dTheta
+}
@@ -0,0 +1,62 @@
+package numik
+
+import (
+	"math"
+	"testing"
+)
+
+func TestChainSolveReachesTarget(t *testing.T) {
+	var c Chain
+	c.AddSegment(5, -math.Pi, math.Pi)
+	c.AddSegment(3, -math.Pi, math.Pi)
+	c.AddSegment(2, -math.Pi, math.Pi)
+
+	target := Vec2{X: 6, Y: 2}
+	theta, err := c.Solve(target)
+	if err != nil {
+		t.Fatalf("Solve(%v): %v", target, err)
+	}
+
+	got := c.endEffector(theta)
+	if math.Hypot(got.X-target.X, got.Y-target.Y) > 1e-3 {
+		t.Errorf("endEffector(theta) = %+v, want within 1e-3 of %+v", got, target)
+	}
+}
+
+func TestChainSolveRejectsUnreachableTarget(t *testing.T) {
+	var c Chain
+	c.AddSegment(5, -math.Pi, math.Pi)
+	c.AddSegment(3, -math.Pi, math.Pi)
+
+	if _, err := c.Solve(Vec2{X: 100, Y: 0}); err == nil {
+		t.Error("Solve(target outside annulus) = nil error, want error")
+	}
+}
+
+func TestSolveFABRIKReachesTarget(t *testing.T) {
+	var c Chain
+	c.AddSegment(5, -math.Pi, math.Pi)
+	c.AddSegment(3, -math.Pi, math.Pi)
+	c.AddSegment(2, -math.Pi, math.Pi)
+
+	target := Vec2{X: 6, Y: 2}
+	theta, err := SolveFABRIK(&c, target)
+	if err != nil {
+		t.Fatalf("SolveFABRIK(%v): %v", target, err)
+	}
+
+	got := c.endEffector(theta)
+	if math.Hypot(got.X-target.X, got.Y-target.Y) > 1e-3 {
+		t.Errorf("endEffector(theta) = %+v, want within 1e-3 of %+v", got, target)
+	}
+}
+
+func TestSolveFABRIKRejectsUnreachableTarget(t *testing.T) {
+	var c Chain
+	c.AddSegment(5, -math.Pi, math.Pi)
+	c.AddSegment(3, -math.Pi, math.Pi)
+
+	if _, err := SolveFABRIK(&c, Vec2{X: 100, Y: 0}); err == nil {
+		t.Error("SolveFABRIK(target outside annulus) = nil error, want error")
+	}
+}
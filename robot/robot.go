@@ -0,0 +1,85 @@
+// Package robot ties the IK solver, the trajectory planner, and a hardware
+// ServoBus together, so that moving the physical arm is one function call
+// away from a target Cartesian position.
+package robot
+
+import (
+	"time"
+
+	"github.com/AppliedGo/roboticarm/driver"
+	"github.com/AppliedGo/roboticarm/numik"
+	"github.com/AppliedGo/roboticarm/trajectory"
+)
+
+// Robot is a kinematic chain whose joints are driven by a ServoBus.
+type Robot struct {
+	Chain *numik.Chain
+	Bus   driver.ServoBus
+}
+
+// New returns a Robot that solves motion for chain and sends the resulting
+// joint angles to bus.
+func New(chain *numik.Chain, bus driver.ServoBus) *Robot {
+	return &Robot{Chain: chain, Bus: bus}
+}
+
+// MoveTo solves for the joint angles that reach target and sends them to
+// the servo bus in a single call.
+func (r *Robot) MoveTo(target numik.Vec2) error {
+	theta, err := r.Chain.Solve(target)
+	if err != nil {
+		return err
+	}
+	return r.Bus.SetAngles(anglesByJoint(theta))
+}
+
+// Follow solves for and sends the joint angles for every waypoint of path
+// in order, so the arm's end effector traces it. path is typically the
+// output of trajectory.Linear, trajectory.Arc, or trajectory.Bezier.
+//
+// Follow sends waypoints back-to-back as fast as the solver runs, with no
+// regard for how far apart they are in space or time -- exactly the
+// point-to-point jumps a velocity profile exists to avoid. Callers that
+// built samples with trajectory.TimeProfile should use FollowProfile
+// instead, so the arm actually moves at the profiled speed.
+func (r *Robot) Follow(path []trajectory.Vec2) error {
+	for _, waypoint := range path {
+		if err := r.MoveTo(waypoint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FollowProfile sends the joint angles for every sample of a time-profiled
+// trajectory (as produced by trajectory.TimeProfile), pacing delivery so
+// each sample reaches the bus at its T offset from the start of the move --
+// giving the smooth, velocity-profiled Cartesian motion TimeProfile computes
+// instead of Follow's point-to-point jumps.
+func (r *Robot) FollowProfile(samples []trajectory.Sample) error {
+	if len(samples) == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	for _, sample := range samples {
+		if d := time.Until(start.Add(time.Duration(sample.T * float64(time.Second)))); d > 0 {
+			time.Sleep(d)
+		}
+		if err := r.MoveTo(sample.Pos); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// anglesByJoint turns a solver's ordered joint-angle slice into the
+// jointID-keyed map a ServoBus expects, using the joint's index in the
+// chain as its ID.
+func anglesByJoint(theta []float64) map[int]float64 {
+	angles := make(map[int]float64, len(theta))
+	for i, a := range theta {
+		angles[i] = a
+	}
+	return angles
+}
@@ -0,0 +1,108 @@
+package robot
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/AppliedGo/roboticarm/numik"
+	"github.com/AppliedGo/roboticarm/trajectory"
+)
+
+// fakeBus records every call it receives instead of talking to hardware.
+type fakeBus struct {
+	setAngleCalls  []map[int]float64
+	setAnglesCalls []map[int]float64
+	closed         bool
+}
+
+func (b *fakeBus) SetAngle(jointID int, radians float64) error {
+	b.setAngleCalls = append(b.setAngleCalls, map[int]float64{jointID: radians})
+	return nil
+}
+
+func (b *fakeBus) SetAngles(angles map[int]float64) error {
+	b.setAnglesCalls = append(b.setAnglesCalls, angles)
+	return nil
+}
+
+func (b *fakeBus) Close() error {
+	b.closed = true
+	return nil
+}
+
+func newTestChain() *numik.Chain {
+	var c numik.Chain
+	c.AddSegment(5, -math.Pi, math.Pi)
+	c.AddSegment(3, -math.Pi, math.Pi)
+	return &c
+}
+
+func TestMoveToSendsSolvedAngles(t *testing.T) {
+	bus := &fakeBus{}
+	r := New(newTestChain(), bus)
+
+	if err := r.MoveTo(numik.Vec2{X: 6, Y: 2}); err != nil {
+		t.Fatalf("MoveTo: %v", err)
+	}
+	if len(bus.setAnglesCalls) != 1 {
+		t.Fatalf("SetAngles called %d times, want 1", len(bus.setAnglesCalls))
+	}
+}
+
+func TestMoveToRejectsUnreachableTarget(t *testing.T) {
+	bus := &fakeBus{}
+	r := New(newTestChain(), bus)
+
+	if err := r.MoveTo(numik.Vec2{X: 100, Y: 0}); err == nil {
+		t.Error("MoveTo(unreachable target) = nil error, want error")
+	}
+}
+
+func TestFollowVisitsEveryWaypoint(t *testing.T) {
+	bus := &fakeBus{}
+	r := New(newTestChain(), bus)
+
+	path := []trajectory.Vec2{{X: 6, Y: 2}, {X: 5, Y: 3}, {X: 4, Y: 1}}
+	if err := r.Follow(path); err != nil {
+		t.Fatalf("Follow: %v", err)
+	}
+	if len(bus.setAnglesCalls) != len(path) {
+		t.Errorf("SetAngles called %d times, want %d", len(bus.setAnglesCalls), len(path))
+	}
+}
+
+func TestFollowProfilePacesDelivery(t *testing.T) {
+	bus := &fakeBus{}
+	r := New(newTestChain(), bus)
+
+	samples := []trajectory.Sample{
+		{T: 0, Pos: trajectory.Vec2{X: 6, Y: 2}},
+		{T: 0.05, Pos: trajectory.Vec2{X: 5, Y: 3}},
+	}
+
+	start := time.Now()
+	if err := r.FollowProfile(samples); err != nil {
+		t.Fatalf("FollowProfile: %v", err)
+	}
+	elapsed := time.Since(start)
+
+	if elapsed < 50*time.Millisecond {
+		t.Errorf("FollowProfile returned after %v, want at least 50ms to honor the last sample's T", elapsed)
+	}
+	if len(bus.setAnglesCalls) != len(samples) {
+		t.Errorf("SetAngles called %d times, want %d", len(bus.setAnglesCalls), len(samples))
+	}
+}
+
+func TestFollowProfileEmptySamplesIsNoOp(t *testing.T) {
+	bus := &fakeBus{}
+	r := New(newTestChain(), bus)
+
+	if err := r.FollowProfile(nil); err != nil {
+		t.Fatalf("FollowProfile(nil): %v", err)
+	}
+	if len(bus.setAnglesCalls) != 0 {
+		t.Errorf("SetAngles called %d times, want 0", len(bus.setAnglesCalls))
+	}
+}
@@ -118,7 +118,6 @@ And that's it. Let's pour this into code now.
 ## The code
 */
 
-//
 package main
 
 // Only the plain `math` package is needed for the formulas.
@@ -149,11 +148,39 @@ func distance(x, y float64) float64 {
 	return math.Sqrt(x*x + y*y)
 }
 
-// Calculating the two joint angles for given x and y.
-func angles(x, y float64) (A1, A2 float64) {
+// reachable reports whether (x,y) lies within the annulus that the two
+// segments can sweep together: no closer than |len1-len2| (the arm folded
+// onto itself) and no farther than len1+len2 (the arm fully stretched out).
+func reachable(x, y float64) bool {
+	dist := distance(x, y)
+	return dist <= len1+len2 && dist >= math.Abs(len1-len2)
+}
+
+// AnglesAll calculates both valid joint-angle solutions for the given x and
+// y. `Acos` always returns a value in [0, Pi], so D2 (and the A2 it feeds
+// into) only ever describes one direction the elbow can bend in; the second,
+// mirrored solution is obtained by adding D2 to A1 instead and negating A2.
+//
+// It returns an error instead of the NaN that the previous, single-solution
+// `angles` produced whenever (x,y) was out of reach.
+func AnglesAll(x, y float64) (solutions [2][2]float64, err error) {
+	if !reachable(x, y) {
+		return solutions, fmt.Errorf("(%v, %v) is out of reach: distance is %v, must be between %v and %v", x, y, distance(x, y), math.Abs(len1-len2), len1+len2)
+	}
+
 	// First, get the length of line *dist*.
 	dist := distance(x, y)
 
+	// The triangle degenerates when dist is 0 (only possible if
+	// len1 == len2): lawOfCosines would divide by zero. The arm is simply
+	// folded all the way back onto itself, so there is really only one
+	// solution here, not two mirrored ones.
+	if dist == 0 {
+		solutions[0] = [2]float64{0, math.Pi}
+		solutions[1] = [2]float64{0, math.Pi}
+		return solutions, nil
+	}
+
 	// Calculating angle D1 is trivial.
 	// `Atan2` is a modified *arctan()* function that [returns unambiguous results.](https://golang.org/pkg/math/#Atan2)
 	D1 := math.Atan2(y, x)
@@ -162,14 +189,47 @@ func angles(x, y float64) (A1, A2 float64) {
 	// a = dist, b = len1, and c = len2.
 	D2 := lawOfCosines(dist, len1, len2)
 
-	// Then A1 is simply the sum of D1 and D2.
-	A1 = D1 + D2
-
 	// A2 can also be calculated with the law of cosine, but this time with
-	// a = len1, b = len2, and c = dist.
-	A2 = lawOfCosines(len1, len2, dist)
+	// a = len1, b = len2, and c = dist. That gives the triangle's interior
+	// angle at the elbow -- between the ray back to the origin and the ray
+	// out to the target -- which is Pi at full extension. A2 is used below
+	// as the angle FK measures forward from segment 1's own direction, so
+	// it needs the supplement: Pi - interior, which is 0 at full extension.
+	A2 := math.Pi - lawOfCosines(len1, len2, dist)
+
+	// Elbow-down: A1 subtracts D2, A2 as calculated.
+	solutions[0] = [2]float64{D1 - D2, A2}
+	// Elbow-up: A1 adds D2 instead, and the elbow bends the other way.
+	solutions[1] = [2]float64{D1 + D2, -A2}
+
+	return solutions, nil
+}
+
+// AnglesPreferred picks, out of the two solutions AnglesAll returns, the one
+// that requires the least total joint travel from prev. This matters for
+// continuous trajectories: jumping between the elbow-up and elbow-down
+// configurations mid-move requires the arm to swing through a pose it
+// cannot physically pass through in one step.
+func AnglesPreferred(x, y float64, prev [2]float64) (best [2]float64, err error) {
+	solutions, err := AnglesAll(x, y)
+	if err != nil {
+		return best, err
+	}
+
+	best = solutions[0]
+	bestTravel := travel(best, prev)
+	for _, s := range solutions[1:] {
+		if t := travel(s, prev); t < bestTravel {
+			best, bestTravel = s, t
+		}
+	}
+	return best, nil
+}
 
-	return A1, A2
+// travel is the total joint-angle distance between two poses, summed over
+// both joints.
+func travel(a, b [2]float64) float64 {
+	return math.Abs(a[0]-b[0]) + math.Abs(a[1]-b[1])
 }
 
 // Convert radians into degrees.
@@ -177,54 +237,70 @@ func deg(rad float64) float64 {
 	return rad * 180 / math.Pi
 }
 
+// printSolutions prints both elbow configurations AnglesAll found for
+// (x,y), or the error if the point is out of reach.
+func printSolutions(x, y float64) {
+	solutions, err := AnglesAll(x, y)
+	if err != nil {
+		fmt.Printf("x=%v, y=%v: %v\n", x, y, err)
+		return
+	}
+	for i, s := range solutions {
+		a1, a2 := s[0], s[1]
+		fmt.Printf("x=%v, y=%v, solution %d: A1=%v (%v°), A2=%v (%v°)\n", x, y, i, a1, deg(a1), a2, deg(a2))
+	}
+}
+
 func main() {
 
 	fmt.Println("Lets do some tests. First move to (5,5):")
-	x, y := 5.0, 5.0
-	a1, a2 := angles(x, y)
-	fmt.Printf("x=%v, y=%v: A1=%v (%v°), A2=%v (%v°)\n", x, y, a1, deg(a1), a2, deg(a2))
+	printSolutions(5, 5)
 
 	fmt.Println("If y is 0 and x = Sqrt(10^2 + 10^2), then alpha should become 45 degrees and beta should become 90 degrees.")
-	x, y = math.Sqrt(200), 0
-	a1, a2 = angles(x, y)
-	fmt.Printf("x=%v, y=%v: A1=%v (%v°), A2=%v (%v°)\n", x, y, a1, deg(a1), a2, deg(a2))
+	printSolutions(math.Sqrt(200), 0)
 
 	fmt.Println("Now let's try moving to (1, 19).")
-	x, y = 1, 19
-	a1, a2 = angles(x, y)
-	fmt.Printf("x=%v, y=%v: A1=%v (%v°), A2=%v (%v°)\n", x, y, a1, deg(a1), a2, deg(a2))
+	printSolutions(1, 19)
 
 	fmt.Println("n extreme case: (20,0). The arm needs to stretch along the y axis.")
-	x, y = 20, 0
-	a1, a2 = angles(x, y)
-	fmt.Printf("x=%v, y=%v: A1=%v (%v°), A2=%v (%v°)\n", x, y, a1, deg(a1), a2, deg(a2))
+	printSolutions(20, 0)
 
 	fmt.Println("And (0,20).")
-	x, y = 0, 20
-	a1, a2 = angles(x, y)
-	fmt.Printf("x=%v, y=%v: A1=%v (%v°), A2=%v (%v°)\n", x, y, a1, deg(a1), a2, deg(a2))
+	printSolutions(0, 20)
 
 	fmt.Println("Moving to (0,0) technically works if the arm segments have the same length, and if the arm does not block itself. Still the result looks a bit weird!?")
-	x, y = 0, 0
-	a1, a2 = angles(x, y)
-	fmt.Printf("x=%v, y=%v: A1=%v (%v°), A2=%v (%v°)\n", x, y, a1, deg(a1), a2, deg(a2))
-
-	fmt.Println("What happens if the target point is outside the reach? Like (20,20).")
-	x, y = 20, 20
-	a1, a2 = angles(x, y)
-	fmt.Printf("x=%v, y=%v: A1=%v (%v°), A2=%v (%v°)\n", x, y, a1, deg(a1), a2, deg(a2))
+	printSolutions(0, 0)
+
+	fmt.Println("What happens if the target point is outside the reach? Like (20,20). AnglesAll now reports this cleanly instead of returning NaN.")
+	printSolutions(20, 20)
+
+	fmt.Println("Finally, AnglesPreferred keeps a trajectory from flipping elbows: moving from (5,5) to (5,5.1) should barely change the pose.")
+	prev, err := AnglesPreferred(5, 5, [2]float64{})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	next, err := AnglesPreferred(5, 5.1, prev)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("prev: A1=%v (%v°), A2=%v (%v°)\n", prev[0], deg(prev[0]), prev[1], deg(prev[1]))
+	fmt.Printf("next: A1=%v (%v°), A2=%v (%v°)\n", next[0], deg(next[0]), next[1], deg(next[1]))
 }
 
 /*
 
 ## "Homework assignment"
 
-Why does *A1* evaluate to `NaN` ("Not a Number") when we try moving the arm to (0,0)?
+Why did *A1* use to evaluate to `NaN` ("Not a Number") when we tried moving the arm to (0,0)?
 
-Hint 1: It is about the law of cosines function.
+Hint 1: It was about the law of cosines function.
 
 Hint 2: The arm does not form a triangle in this case. (Yes, this hint is actually a spoiler, sorry...)
 
+`AnglesAll` now special-cases this degenerate, zero-length `dist` explicitly, and reports out-of-reach targets as an error rather than letting them flow into `Acos` and come out as `NaN`.
+
 
 ## Outlook
 
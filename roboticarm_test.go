@@ -0,0 +1,51 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAnglesAllBothSolutionsReachTarget(t *testing.T) {
+	x, y := 12.0, 7.0
+	solutions, err := AnglesAll(x, y)
+	if err != nil {
+		t.Fatalf("AnglesAll(%v, %v): %v", x, y, err)
+	}
+
+	for i, s := range solutions {
+		a1, a2 := s[0], s[1]
+		gotX := len1*math.Cos(a1) + len2*math.Cos(a1+a2)
+		gotY := len1*math.Sin(a1) + len2*math.Sin(a1+a2)
+		if math.Abs(gotX-x) > 1e-9 || math.Abs(gotY-y) > 1e-9 {
+			t.Errorf("solution %d: forward kinematics gives (%v, %v), want (%v, %v)", i, gotX, gotY, x, y)
+		}
+	}
+}
+
+func TestAnglesAllRejectsUnreachableTarget(t *testing.T) {
+	if _, err := AnglesAll(20, 20); err == nil {
+		t.Error("AnglesAll(20, 20) = nil error, want error")
+	}
+}
+
+func TestAnglesPreferredMinimizesTravel(t *testing.T) {
+	prev, err := AnglesPreferred(5, 5, [2]float64{})
+	if err != nil {
+		t.Fatalf("AnglesPreferred(5, 5, zero): %v", err)
+	}
+
+	next, err := AnglesPreferred(5, 5.1, prev)
+	if err != nil {
+		t.Fatalf("AnglesPreferred(5, 5.1, prev): %v", err)
+	}
+
+	solutions, err := AnglesAll(5, 5.1)
+	if err != nil {
+		t.Fatalf("AnglesAll(5, 5.1): %v", err)
+	}
+	for _, s := range solutions {
+		if travel(next, prev) > travel(s, prev)+1e-9 {
+			t.Errorf("AnglesPreferred picked %v with travel %v, but %v has lower travel %v", next, travel(next, prev), s, travel(s, prev))
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Package trajectory generates Cartesian paths and time-parameterized
+// motion profiles for a robot arm to follow. Feeding the waypoints it
+// produces through the numik or geometric solvers yields joint angles at
+// each timestep, so a robot traces a line, an arc, or a curve instead of
+// only ever jumping straight from one point to the next.
+package trajectory
+
+import (
+	"errors"
+	"math"
+
+	"github.com/AppliedGo/roboticarm/numik"
+)
+
+// Vec2 is the same 2D point type numik uses; it is aliased here so that
+// trajectory's exported signatures read naturally on their own.
+type Vec2 = numik.Vec2
+
+// Linear returns steps Cartesian waypoints (at least two) tracing the
+// straight line from from to to, including both endpoints.
+func Linear(from, to Vec2, steps int) []Vec2 {
+	steps = atLeastTwo(steps)
+	waypoints := make([]Vec2, steps)
+	for i := 0; i < steps; i++ {
+		t := float64(i) / float64(steps-1)
+		waypoints[i] = Vec2{
+			X: from.X + t*(to.X-from.X),
+			Y: from.Y + t*(to.Y-from.Y),
+		}
+	}
+	return waypoints
+}
+
+// Arc returns steps Cartesian waypoints (at least two) tracing a circular arc of the given
+// radius around center, sweeping from startAng to endAng (in radians).
+func Arc(center Vec2, radius, startAng, endAng float64, steps int) []Vec2 {
+	steps = atLeastTwo(steps)
+	waypoints := make([]Vec2, steps)
+	for i := 0; i < steps; i++ {
+		t := float64(i) / float64(steps-1)
+		angle := startAng + t*(endAng-startAng)
+		waypoints[i] = Vec2{
+			X: center.X + radius*math.Cos(angle),
+			Y: center.Y + radius*math.Sin(angle),
+		}
+	}
+	return waypoints
+}
+
+// Bezier returns steps Cartesian waypoints (at least two) along the Bezier curve defined by
+// controlPoints, evaluated with De Casteljau's algorithm. It returns an error
+// if fewer than two control points are given, since a curve needs at least a
+// start and an end point.
+func Bezier(controlPoints []Vec2, steps int) ([]Vec2, error) {
+	if len(controlPoints) < 2 {
+		return nil, errors.New("trajectory: Bezier requires at least 2 control points")
+	}
+
+	steps = atLeastTwo(steps)
+	waypoints := make([]Vec2, steps)
+	for i := 0; i < steps; i++ {
+		t := float64(i) / float64(steps-1)
+		waypoints[i] = deCasteljau(controlPoints, t)
+	}
+	return waypoints, nil
+}
+
+// deCasteljau evaluates the Bezier curve defined by points at parameter t in
+// [0,1] via repeated linear interpolation between consecutive points.
+func deCasteljau(points []Vec2, t float64) Vec2 {
+	work := make([]Vec2, len(points))
+	copy(work, points)
+	for len(work) > 1 {
+		for i := 0; i < len(work)-1; i++ {
+			work[i] = Vec2{
+				X: work[i].X + t*(work[i+1].X-work[i].X),
+				Y: work[i].Y + t*(work[i+1].Y-work[i].Y),
+			}
+		}
+		work = work[:len(work)-1]
+	}
+	return work[0]
+}
+
+// atLeastTwo clamps steps to a minimum of 2, since a path needs at least a
+// start and an end point.
+func atLeastTwo(steps int) int {
+	if steps < 2 {
+		return 2
+	}
+	return steps
+}
@@ -0,0 +1,11 @@
+package trajectory
+
+import "testing"
+
+func TestBezierRejectsTooFewControlPoints(t *testing.T) {
+	for _, points := range [][]Vec2{nil, {{X: 0, Y: 0}}} {
+		if _, err := Bezier(points, 10); err == nil {
+			t.Errorf("Bezier(%v, 10) = nil error, want error", points)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package trajectory
+
+import (
+	"errors"
+	"math"
+)
+
+// ProfileKind selects the shape of the velocity ramp TimeProfile uses
+// during acceleration and deceleration.
+type ProfileKind int
+
+const (
+	// Trapezoidal ramps velocity up and down linearly, so acceleration
+	// jumps instantaneously at the start and end of each ramp.
+	Trapezoidal ProfileKind = iota
+	// SCurve ramps velocity along a smoothstep curve instead, so
+	// acceleration itself rises and falls smoothly -- gentler on real
+	// servos and gearboxes, at the cost of a slightly longer ramp for
+	// the same distance.
+	SCurve
+)
+
+// Sample is one timestamped point on a trajectory.
+type Sample struct {
+	T   float64
+	Pos Vec2
+}
+
+// TimeProfile walks path (as produced by Linear, Arc or Bezier) under a
+// velocity profile of the given kind: accelerate at maxAccel up to maxVel,
+// cruise, then decelerate back to a stop at the path's last waypoint. It
+// samples the profile every dt seconds.
+//
+// If path is too short for the profile to reach maxVel before it must start
+// decelerating again, TimeProfile degrades gracefully to a triangular
+// profile that never reaches maxVel.
+//
+// maxVel, maxAccel and dt must all be positive: the resulting profile's
+// duration depends on dividing by maxVel and maxAccel, and dt must make
+// forward progress through it, so returning zero-length sample slices
+// instead of erroring would silently hang the caller.
+func TimeProfile(path []Vec2, kind ProfileKind, maxVel, maxAccel, dt float64) ([]Sample, error) {
+	if maxVel <= 0 || maxAccel <= 0 || dt <= 0 {
+		return nil, errors.New("trajectory: maxVel, maxAccel and dt must all be positive")
+	}
+	if len(path) < 2 {
+		return nil, nil
+	}
+
+	dists := cumulativeLengths(path)
+	total := dists[len(dists)-1]
+	if total == 0 {
+		return []Sample{{T: 0, Pos: path[0]}}, nil
+	}
+
+	// The smoothstep velocity curve's steepest slope is 1.5 times that of a
+	// linear ramp of the same duration, so an S-curve ramp is stretched by
+	// that factor to keep its peak acceleration at maxAccel.
+	rampScale := 1.0
+	if kind == SCurve {
+		rampScale = 1.5
+	}
+
+	cruiseVel := maxVel
+	accelDist := rampScale * maxVel * maxVel / (2 * maxAccel)
+	if 2*accelDist > total {
+		// Triangular profile: the path is too short to ever reach maxVel.
+		accelDist = total / 2
+		cruiseVel = math.Sqrt(2 * maxAccel * accelDist / rampScale)
+	}
+	cruiseDist := total - 2*accelDist
+	accelTime := rampScale * cruiseVel / maxAccel
+	cruiseTime := cruiseDist / cruiseVel
+	totalTime := 2*accelTime + cruiseTime
+
+	var samples []Sample
+	for t := 0.0; t < totalTime; t += dt {
+		s := distanceAtTime(kind, t, accelTime, cruiseTime, accelDist, cruiseDist)
+		samples = append(samples, Sample{T: t, Pos: posAtDistance(path, dists, s)})
+	}
+	// Always land exactly on the path's end point.
+	samples = append(samples, Sample{T: totalTime, Pos: path[len(path)-1]})
+	return samples, nil
+}
+
+// distanceAtTime returns the distance travelled along the path at time t,
+// given the durations and lengths of the profile's accelerate/cruise/
+// decelerate phases.
+func distanceAtTime(kind ProfileKind, t, accelTime, cruiseTime, accelDist, cruiseDist float64) float64 {
+	switch {
+	case t < accelTime:
+		return accelDist * profileFraction(kind, t/accelTime)
+	case t < accelTime+cruiseTime:
+		return accelDist + cruiseDist*(t-accelTime)/cruiseTime
+	default:
+		td := t - accelTime - cruiseTime
+		remaining := 1 - profileFraction(kind, (accelTime-td)/accelTime)
+		return accelDist + cruiseDist + accelDist*remaining
+	}
+}
+
+// profileFraction returns the fraction (in [0,1]) of a single accelerate-or-
+// decelerate phase's distance covered after a fraction x (in [0,1]) of its
+// duration, for the given profile kind.
+func profileFraction(kind ProfileKind, x float64) float64 {
+	if kind == SCurve {
+		// Integral of the smoothstep velocity curve 3x^2-2x^3, normalized
+		// to 1 at x=1.
+		return 2*x*x*x - x*x*x*x
+	}
+	// Linear acceleration: distance grows with the square of time.
+	return x * x
+}
+
+// cumulativeLengths returns, for each waypoint, the arc length of path from
+// its first waypoint up to and including that one.
+func cumulativeLengths(path []Vec2) []float64 {
+	dists := make([]float64, len(path))
+	for i := 1; i < len(path); i++ {
+		dists[i] = dists[i-1] + math.Hypot(path[i].X-path[i-1].X, path[i].Y-path[i-1].Y)
+	}
+	return dists
+}
+
+// posAtDistance finds the point on path at arc-length distance s, linearly
+// interpolating between the bracketing waypoints. dists holds the
+// cumulative arc length at each waypoint, as returned by cumulativeLengths.
+func posAtDistance(path []Vec2, dists []float64, s float64) Vec2 {
+	if s <= 0 {
+		return path[0]
+	}
+	if s >= dists[len(dists)-1] {
+		return path[len(path)-1]
+	}
+	for i := 1; i < len(dists); i++ {
+		if s <= dists[i] {
+			segLen := dists[i] - dists[i-1]
+			t := 0.0
+			if segLen > 0 {
+				t = (s - dists[i-1]) / segLen
+			}
+			return Vec2{
+				X: path[i-1].X + t*(path[i].X-path[i-1].X),
+				Y: path[i-1].Y + t*(path[i].Y-path[i-1].Y),
+			}
+		}
+	}
+	return path[len(path)-1]
+}
@@ -0,0 +1,41 @@
+package trajectory
+
+import "testing"
+
+func TestTimeProfileRejectsNonPositiveInputs(t *testing.T) {
+	path := []Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}}
+
+	cases := []struct {
+		name                 string
+		maxVel, maxAccel, dt float64
+	}{
+		{"zero maxVel", 0, 1, 0.1},
+		{"negative maxVel", -1, 1, 0.1},
+		{"zero maxAccel", 1, 0, 0.1},
+		{"negative maxAccel", 1, -1, 0.1},
+		{"zero dt", 1, 1, 0},
+		{"negative dt", 1, 1, -0.1},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := TimeProfile(path, Trapezoidal, c.maxVel, c.maxAccel, c.dt); err == nil {
+				t.Errorf("TimeProfile(%v, %v, %v) = nil error, want error", c.maxVel, c.maxAccel, c.dt)
+			}
+		})
+	}
+}
+
+func TestTimeProfileReachesEnd(t *testing.T) {
+	path := []Vec2{{X: 0, Y: 0}, {X: 1, Y: 0}}
+	samples, err := TimeProfile(path, Trapezoidal, 1, 1, 0.05)
+	if err != nil {
+		t.Fatalf("TimeProfile: %v", err)
+	}
+	if len(samples) == 0 {
+		t.Fatal("TimeProfile returned no samples")
+	}
+	last := samples[len(samples)-1]
+	if last.Pos != (Vec2{X: 1, Y: 0}) {
+		t.Errorf("last sample = %+v, want path's end point", last.Pos)
+	}
+}